@@ -0,0 +1,45 @@
+package forecast
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const darkSkyBaseURL = "https://api.darksky.net/forecast"
+
+// DarkSky is a Provider backed by the (deprecated) Dark Sky API. It is kept
+// around for users who still have an API key, but new installs should
+// prefer OpenMeteo since Dark Sky no longer accepts new signups.
+type DarkSky struct {
+	// APIKey is the Dark Sky API key.
+	APIKey string
+	// Lang is passed through to Dark Sky's lang= query param, e.g. "en".
+	Lang string
+}
+
+// Fetch retrieves the forecast from Dark Sky for the given coordinates.
+func (d DarkSky) Fetch(lat, lon float64, units string) (Forecast, error) {
+	var f Forecast
+
+	url := fmt.Sprintf("%s/%s/%f,%f?units=%s", darkSkyBaseURL, d.APIKey, lat, lon, units)
+	if d.Lang != "" {
+		url += "&lang=" + d.Lang
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return f, fmt.Errorf("getting darksky forecast: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return f, fmt.Errorf("darksky: unexpected status code %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&f); err != nil {
+		return f, fmt.Errorf("decoding darksky forecast: %v", err)
+	}
+
+	return f, nil
+}
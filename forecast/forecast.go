@@ -0,0 +1,78 @@
+package forecast
+
+// Forecast is the full weather report for a location: the current
+// conditions plus minute/hour/day breakdowns and any active alerts.
+type Forecast struct {
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Timezone  string    `json:"timezone"`
+	Currently Weather   `json:"currently"`
+	Minutely  DataBlock `json:"minutely"`
+	Hourly    DataBlock `json:"hourly"`
+	Daily     DataBlock `json:"daily"`
+	Alerts    []Alert   `json:"alerts"`
+	Flags     Flags     `json:"flags"`
+}
+
+// DataBlock is a named collection of Weather readings, e.g. the hourly or
+// daily block of a Forecast.
+type DataBlock struct {
+	Summary string    `json:"summary"`
+	Icon    string    `json:"icon"`
+	Data    []Weather `json:"data"`
+}
+
+// Weather is a single point-in-time (or daily-summary) weather reading.
+// Not every field is populated by every backend or in every block; zero
+// values are treated as "not reported" by the Print* functions.
+type Weather struct {
+	Time    int64  `json:"time"`
+	Summary string `json:"summary"`
+	Icon    string `json:"icon"`
+
+	// SunriseTime and SunsetTime bound the daylight window this reading
+	// falls in, used to pick day/night icon variants locally for backends
+	// that don't distinguish them in their condition code.
+	SunriseTime int64 `json:"sunriseTime"`
+	SunsetTime  int64 `json:"sunsetTime"`
+
+	Temperature            float64 `json:"temperature"`
+	TemperatureMin         float64 `json:"temperatureMin"`
+	TemperatureMinTime     int64   `json:"temperatureMinTime"`
+	TemperatureMax         float64 `json:"temperatureMax"`
+	TemperatureMaxTime     int64   `json:"temperatureMaxTime"`
+	ApparentTemperature    float64 `json:"apparentTemperature"`
+	ApparentTemperatureMin float64 `json:"apparentTemperatureMin"`
+	ApparentTemperatureMax float64 `json:"apparentTemperatureMax"`
+
+	PrecipIntensity   float64 `json:"precipIntensity"`
+	PrecipProbability float64 `json:"precipProbability"`
+	PrecipType        string  `json:"precipType"`
+
+	NearestStormDistance float64 `json:"nearestStormDistance"`
+	NearestStormBearing  float64 `json:"nearestStormBearing"`
+
+	DewPoint    float64 `json:"dewPoint"`
+	Humidity    float64 `json:"humidity"`
+	Pressure    float64 `json:"pressure"`
+	WindSpeed   float64 `json:"windSpeed"`
+	WindBearing float64 `json:"windBearing"`
+	CloudCover  float64 `json:"cloudCover"`
+	Visibility  float64 `json:"visibility"`
+	Ozone       float64 `json:"ozone"`
+}
+
+// Alert is a weather alert/advisory issued for the forecast's location.
+type Alert struct {
+	Title       string `json:"title"`
+	Time        int64  `json:"time"`
+	Expires     int64  `json:"expires"`
+	Description string `json:"description"`
+	URI         string `json:"uri"`
+}
+
+// Flags carry metadata about how a Forecast was produced.
+type Flags struct {
+	Sources []string `json:"sources"`
+	Units   string   `json:"units"`
+}
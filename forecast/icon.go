@@ -0,0 +1,133 @@
+package forecast
+
+import "strings"
+
+// Icon identifies a weather condition independent of any particular
+// backend's icon naming scheme.
+type Icon int
+
+// The set of icons this package knows how to render.
+const (
+	IconUnknown Icon = iota
+	IconClear
+	IconClearDay
+	IconClearNight
+	IconClouds
+	IconCloudy
+	IconCloudsNight
+	IconFog
+	IconHaze
+	IconHazeNight
+	IconPartlyCloudyDay
+	IconPartlyCloudyNight
+	IconRain
+	IconSleet
+	IconSnow
+	IconThunderstorm
+	IconTornado
+	IconWind
+)
+
+// parseIcon maps a backend's icon string (e.g. Dark Sky's "partly-cloudy-day")
+// to this package's Icon enum.
+func parseIcon(iconStr string) Icon {
+	// steralize the icon string name
+	iconStr = strings.Replace(strings.Replace(iconStr, "-", "", -1), "_", "", -1)
+
+	switch iconStr {
+	case "clear":
+		return IconClear
+	case "clearday":
+		return IconClearDay
+	case "clearnight":
+		return IconClearNight
+	case "clouds":
+		return IconClouds
+	case "cloudy":
+		return IconCloudy
+	case "cloudsnight":
+		return IconCloudsNight
+	case "fog":
+		return IconFog
+	case "haze":
+		return IconHaze
+	case "hazenight":
+		return IconHazeNight
+	case "partlycloudyday":
+		return IconPartlyCloudyDay
+	case "partlycloudynight":
+		return IconPartlyCloudyNight
+	case "rain":
+		return IconRain
+	case "sleet":
+		return IconSleet
+	case "snow":
+		return IconSnow
+	case "thunderstorm":
+		return IconThunderstorm
+	case "tornado":
+		return IconTornado
+	case "wind":
+		return IconWind
+	}
+
+	return IconUnknown
+}
+
+// daylightWindow finds the sunrise/sunset bounding the most recent day at or
+// before t in daily, or (0, 0) if daily carries no sunrise data at all (e.g.
+// the block simply wasn't fetched).
+func daylightWindow(daily DataBlock, t int64) (sunrise, sunset int64) {
+	for _, day := range daily.Data {
+		if day.SunriseTime == 0 {
+			continue
+		}
+		if t >= day.SunriseTime {
+			sunrise, sunset = day.SunriseTime, day.SunsetTime
+		}
+	}
+	return sunrise, sunset
+}
+
+// nightVariant returns the night counterpart of icon, if it has one.
+func nightVariant(icon Icon) (Icon, bool) {
+	switch icon {
+	case IconClear, IconClearDay:
+		return IconClearNight, true
+	case IconClouds, IconCloudy:
+		return IconCloudsNight, true
+	case IconHaze:
+		return IconHazeNight, true
+	case IconPartlyCloudyDay:
+		return IconPartlyCloudyNight, true
+	}
+	return icon, false
+}
+
+// resolveIcon parses iconStr and, unless it already names a night variant
+// (the backend told us explicitly, e.g. Dark Sky's "-night" icons), swaps in
+// the night counterpart when t falls outside [sunrise, sunset). This lets
+// backends like Open-Meteo, whose condition codes don't distinguish day from
+// night, still get the right icon.
+func resolveIcon(iconStr string, t, sunrise, sunset int64) Icon {
+	icon := parseIcon(iconStr)
+
+	switch icon {
+	case IconClearNight, IconCloudsNight, IconHazeNight, IconPartlyCloudyNight:
+		return icon
+	}
+
+	if sunrise == 0 && sunset == 0 {
+		return icon
+	}
+
+	if t >= sunrise && t < sunset {
+		return icon
+	}
+
+	if night, ok := nightVariant(icon); ok {
+		return night
+	}
+
+	return icon
+}
@@ -0,0 +1,87 @@
+package forecast
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+//go:embed assets/icons/*.png
+var bitmapIconAssets embed.FS
+
+// bitmapIconFiles maps each Icon to the basename (under assets/icons) of its
+// PNG asset, using the same icon codes OpenWeatherMap uses so the assets are
+// easy to refresh from a wider icon set later.
+var bitmapIconFiles = map[Icon]string{
+	IconClear:             "01d",
+	IconClearDay:          "01d",
+	IconClearNight:        "01n",
+	IconPartlyCloudyDay:   "02d",
+	IconPartlyCloudyNight: "02n",
+	IconClouds:            "03d",
+	IconCloudy:            "04d",
+	IconCloudsNight:       "04d",
+	IconRain:              "10d",
+	IconSleet:             "09d",
+	IconThunderstorm:      "11d",
+	IconSnow:              "13d",
+	IconFog:               "50d",
+	IconHaze:              "50d",
+	IconHazeNight:         "50d",
+}
+
+// BitmapIconRenderer renders icons as PNG images, suitable for small
+// monochrome/e-ink panels such as a 2.13" e-paper display.
+type BitmapIconRenderer struct{}
+
+// RenderANSI is unsupported for BitmapIconRenderer; use ANSIIconRenderer
+// for terminal output.
+func (BitmapIconRenderer) RenderANSI(icon Icon) (string, error) {
+	return "", fmt.Errorf("forecast: BitmapIconRenderer does not support RenderANSI")
+}
+
+// RenderImage decodes icon's PNG asset and scales it to size x size.
+func (BitmapIconRenderer) RenderImage(icon Icon, size int) (image.Image, error) {
+	name, ok := bitmapIconFiles[icon]
+	if !ok {
+		return nil, fmt.Errorf("forecast: no bitmap asset for icon %d", icon)
+	}
+
+	b, err := bitmapIconAssets.ReadFile("assets/icons/" + name + ".png")
+	if err != nil {
+		return nil, fmt.Errorf("reading bitmap asset %s: %v", name, err)
+	}
+
+	src, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("decoding bitmap asset %s: %v", name, err)
+	}
+
+	if size <= 0 || src.Bounds().Dx() == size {
+		return src, nil
+	}
+
+	return scaleNearestNeighbor(src, size), nil
+}
+
+// scaleNearestNeighbor resizes src to size x size. It's a small, dependency
+// free resizer; good enough for the blocky monochrome icons this renderer
+// ships, which don't need a real resampling filter.
+func scaleNearestNeighbor(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	dst := image.NewGray(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		sy := bounds.Min.Y + y*srcH/size
+		for x := 0; x < size; x++ {
+			sx := bounds.Min.X + x*srcW/size
+			dst.Set(x, y, color.GrayModel.Convert(src.At(sx, sy)))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,85 @@
+package forecast
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/jessfraz/weather/icons"
+)
+
+// IconRenderer renders a weather Icon for a particular output medium.
+// Consumers of this package can swap in their own IconRenderer to drive
+// displays this package doesn't ship a renderer for.
+type IconRenderer interface {
+	// RenderANSI returns a colorstring markup string (e.g. "[yellow]...")
+	// for icon, suitable for passing through colorize.
+	RenderANSI(icon Icon) (string, error)
+	// RenderImage renders icon as a size x size image, for bitmap displays
+	// such as e-paper panels.
+	RenderImage(icon Icon, size int) (image.Image, error)
+}
+
+// ANSIIconRenderer renders icons as colored ASCII art for terminals. It's
+// the default renderer used by PrintCurrent and PrintDaily.
+type ANSIIconRenderer struct{}
+
+// RenderANSI returns the colored ASCII art for icon.
+func (ANSIIconRenderer) RenderANSI(icon Icon) (string, error) {
+	color := "blue"
+	var art string
+
+	switch icon {
+	case IconClear:
+		art = icons.Clear
+	case IconClearDay:
+		color = "yellow"
+		art = icons.Clearday
+	case IconClearNight:
+		color = "light_yellow"
+		art = icons.Clearnight
+	case IconClouds:
+		art = icons.Clouds
+	case IconCloudy:
+		art = icons.Cloudy
+	case IconCloudsNight:
+		color = "light_yellow"
+		art = icons.Cloudsnight
+	case IconFog:
+		art = icons.Fog
+	case IconHaze:
+		art = icons.Haze
+	case IconHazeNight:
+		color = "light_yellow"
+		art = icons.Hazenight
+	case IconPartlyCloudyDay:
+		color = "yellow"
+		art = icons.Partlycloudyday
+	case IconPartlyCloudyNight:
+		color = "light_yellow"
+		art = icons.Partlycloudynight
+	case IconRain:
+		art = icons.Rain
+	case IconSleet:
+		art = icons.Sleet
+	case IconSnow:
+		color = "white"
+		art = icons.Snow
+	case IconThunderstorm:
+		color = "black"
+		art = icons.Thunderstorm
+	case IconTornado:
+		color = "black"
+		art = icons.Tornado
+	case IconWind:
+		color = "black"
+		art = icons.Wind
+	}
+
+	return "[" + color + "]" + art, nil
+}
+
+// RenderImage is unsupported for ANSIIconRenderer; use BitmapIconRenderer
+// for image output.
+func (ANSIIconRenderer) RenderImage(icon Icon, size int) (image.Image, error) {
+	return nil, fmt.Errorf("forecast: ANSIIconRenderer does not support RenderImage")
+}
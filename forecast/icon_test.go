@@ -0,0 +1,68 @@
+package forecast
+
+import "testing"
+
+func TestResolveIcon(t *testing.T) {
+	const sunrise, sunset = 1000, 2000
+
+	tests := []struct {
+		name    string
+		iconStr string
+		t       int64
+		sunrise int64
+		sunset  int64
+		want    Icon
+	}{
+		{"day icon within daylight window stays day", "clear-day", 1500, sunrise, sunset, IconClearDay},
+		{"day icon before sunrise becomes night", "clear-day", 500, sunrise, sunset, IconClearNight},
+		{"day icon after sunset becomes night", "partly-cloudy-day", 2500, sunrise, sunset, IconPartlyCloudyNight},
+		{"explicit night icon is left alone even during daylight", "clear-night", 1500, sunrise, sunset, IconClearNight},
+		{"icon with no night variant is unaffected", "rain", 2500, sunrise, sunset, IconRain},
+		{"unknown sunrise/sunset window leaves icon as parsed", "cloudy", 2500, 0, 0, IconCloudy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveIcon(tt.iconStr, tt.t, tt.sunrise, tt.sunset); got != tt.want {
+				t.Errorf("resolveIcon(%q, %d, %d, %d) = %v, want %v", tt.iconStr, tt.t, tt.sunrise, tt.sunset, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaylightWindow(t *testing.T) {
+	daily := DataBlock{
+		Data: []Weather{
+			{Time: 100, SunriseTime: 1000, SunsetTime: 2000},
+			{Time: 86500, SunriseTime: 87000, SunsetTime: 88000},
+		},
+	}
+
+	t.Run("finds the window for the matching day", func(t *testing.T) {
+		sunrise, sunset := daylightWindow(daily, 1500)
+		if sunrise != 1000 || sunset != 2000 {
+			t.Errorf("daylightWindow(daily, 1500) = (%d, %d), want (1000, 2000)", sunrise, sunset)
+		}
+	})
+
+	t.Run("picks the most recent day at or before t", func(t *testing.T) {
+		sunrise, sunset := daylightWindow(daily, 87500)
+		if sunrise != 87000 || sunset != 88000 {
+			t.Errorf("daylightWindow(daily, 87500) = (%d, %d), want (87000, 88000)", sunrise, sunset)
+		}
+	})
+
+	t.Run("returns zero window when t precedes every daily entry", func(t *testing.T) {
+		sunrise, sunset := daylightWindow(daily, 0)
+		if sunrise != 0 || sunset != 0 {
+			t.Errorf("daylightWindow(daily, 0) = (%d, %d), want (0, 0)", sunrise, sunset)
+		}
+	})
+
+	t.Run("returns zero window when daily has no sunrise data", func(t *testing.T) {
+		sunrise, sunset := daylightWindow(DataBlock{Data: []Weather{{Time: 100}}}, 500)
+		if sunrise != 0 || sunset != 0 {
+			t.Errorf("daylightWindow(empty, 500) = (%d, %d), want (0, 0)", sunrise, sunset)
+		}
+	})
+}
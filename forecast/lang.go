@@ -0,0 +1,173 @@
+package forecast
+
+import "sync"
+
+// Catalog holds every user-facing string the forecast package prints, for a
+// single language. Fields ending in a verb phrase are fmt format strings;
+// substitute in the already-colorized/unit-formatted values the same way
+// the English prose did.
+type Catalog struct {
+	HumidityIck          string // e.g. "  Ick! The humidity is %s\n"
+	Humidity             string // e.g. "  The humidity is %s\n"
+	PrecipIntensity      string // e.g. "  The precipitation intensity of %s is %s\n"
+	PrecipProbability    string // e.g. "  The precipitation probability is %s\n"
+	NearestStorm         string // e.g. "  The nearest storm is %s away\n"
+	WindSpeed            string // e.g. "  The wind speed is %s\n"
+	CloudCoverage        string // e.g. "  The cloud coverage is %s\n"
+	Visibility           string // e.g. "  The visibility is %s\n"
+	Pressure             string // e.g. "  The pressure is %s\n\n"
+	CurrentWeather       string // e.g. "\nCurrent weather is %s in %s for %s\n"
+	Temperature          string // e.g. "The temperature is %s\n\n"
+	TemperatureFeelsLike string // e.g. "The temperature is %s, but it feels like %s\n\n"
+	AlertCreated         string // e.g. "Created: "
+	AlertExpires         string // e.g. "Expires: "
+	DailyHigh            string // e.g. "The temperature high is %s, feels like %s around %s,\n"
+	DailyLow             string // e.g. "and low is %s, feels like %s around %s\n\n"
+
+	// Directions are the 16 compass point abbreviations, N first, going
+	// clockwise.
+	Directions []string
+
+	// LongDate, DailyDate, and Hour are time.Format layouts used for full
+	// timestamps, daily forecast headings, and hour-only timestamps,
+	// respectively.
+	LongDate  string
+	DailyDate string
+	Hour      string
+}
+
+var (
+	langsMu sync.RWMutex
+	langs   = map[string]Catalog{
+		"en": {
+			HumidityIck:          "  Ick! The humidity is %s\n",
+			Humidity:             "  The humidity is %s\n",
+			PrecipIntensity:      "  The precipitation intensity of %s is %s\n",
+			PrecipProbability:    "  The precipitation probability is %s\n",
+			NearestStorm:         "  The nearest storm is %s away\n",
+			WindSpeed:            "  The wind speed is %s\n",
+			CloudCoverage:        "  The cloud coverage is %s\n",
+			Visibility:           "  The visibility is %s\n",
+			Pressure:             "  The pressure is %s\n\n",
+			CurrentWeather:       "\nCurrent weather is %s in %s for %s\n",
+			Temperature:          "The temperature is %s\n\n",
+			TemperatureFeelsLike: "The temperature is %s, but it feels like %s\n\n",
+			AlertCreated:         "Created: ",
+			AlertExpires:         "Expires: ",
+			DailyHigh:            "The temperature high is %s, feels like %s around %s,\n",
+			DailyLow:             "and low is %s, feels like %s around %s\n\n",
+			Directions:           []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"},
+			LongDate:             "January 2 at 3:04pm MST",
+			DailyDate:            "January 2 (Monday)",
+			Hour:                 "3:04pm MST",
+		},
+		"de": {
+			HumidityIck:          "  Pfui! Die Luftfeuchtigkeit beträgt %s\n",
+			Humidity:             "  Die Luftfeuchtigkeit beträgt %s\n",
+			PrecipIntensity:      "  Die Niederschlagsintensität von %s beträgt %s\n",
+			PrecipProbability:    "  Die Niederschlagswahrscheinlichkeit beträgt %s\n",
+			NearestStorm:         "  Das nächste Unwetter ist %s entfernt\n",
+			WindSpeed:            "  Die Windgeschwindigkeit beträgt %s\n",
+			CloudCoverage:        "  Der Bewölkungsgrad beträgt %s\n",
+			Visibility:           "  Die Sichtweite beträgt %s\n",
+			Pressure:             "  Der Luftdruck beträgt %s\n\n",
+			CurrentWeather:       "\nAktuelles Wetter ist %s in %s für %s\n",
+			Temperature:          "Die Temperatur beträgt %s\n\n",
+			TemperatureFeelsLike: "Die Temperatur beträgt %s, fühlt sich aber an wie %s\n\n",
+			AlertCreated:         "Erstellt: ",
+			AlertExpires:         "Läuft ab: ",
+			DailyHigh:            "Die Höchsttemperatur beträgt %s, fühlt sich an wie %s gegen %s,\n",
+			DailyLow:             "und die Tiefsttemperatur beträgt %s, fühlt sich an wie %s gegen %s\n\n",
+			Directions:           []string{"N", "NNO", "NO", "ONO", "O", "OSO", "SO", "SSO", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"},
+			LongDate:             "2. January um 15:04 MST",
+			DailyDate:            "2. January (Monday)",
+			Hour:                 "15:04 MST",
+		},
+		"fi": {
+			HumidityIck:          "  Hyi! Ilmankosteus on %s\n",
+			Humidity:             "  Ilmankosteus on %s\n",
+			PrecipIntensity:      "  Sateen %s voimakkuus on %s\n",
+			PrecipProbability:    "  Sateen todennäköisyys on %s\n",
+			NearestStorm:         "  Lähin myrsky on %s päässä\n",
+			WindSpeed:            "  Tuulen nopeus on %s\n",
+			CloudCoverage:        "  Pilvisyys on %s\n",
+			Visibility:           "  Näkyvyys on %s\n",
+			Pressure:             "  Ilmanpaine on %s\n\n",
+			CurrentWeather:       "\nSää nyt on %s paikassa %s ajanhetkellä %s\n",
+			Temperature:          "Lämpötila on %s\n\n",
+			TemperatureFeelsLike: "Lämpötila on %s, mutta tuntuu %s lämpöiseltä\n\n",
+			AlertCreated:         "Luotu: ",
+			AlertExpires:         "Vanhenee: ",
+			DailyHigh:            "Päivän ylämpötila on %s, tuntuu %s ajanhetkellä %s,\n",
+			DailyLow:             "ja alin lämpötila on %s, tuntuu %s ajanhetkellä %s\n\n",
+			Directions:           []string{"P", "PKO", "KO", "IKO", "I", "IKA", "KA", "EKA", "E", "ELO", "LO", "LLO", "L", "LLU", "LU", "PLU"},
+			LongDate:             "2.1. klo 15:04 MST",
+			DailyDate:            "2.1. (Monday)",
+			Hour:                 "15:04 MST",
+		},
+		"fr": {
+			HumidityIck:          "  Berk ! L'humidité est de %s\n",
+			Humidity:             "  L'humidité est de %s\n",
+			PrecipIntensity:      "  L'intensité des précipitations de %s est de %s\n",
+			PrecipProbability:    "  La probabilité de précipitations est de %s\n",
+			NearestStorm:         "  L'orage le plus proche est à %s\n",
+			WindSpeed:            "  La vitesse du vent est de %s\n",
+			CloudCoverage:        "  La couverture nuageuse est de %s\n",
+			Visibility:           "  La visibilité est de %s\n",
+			Pressure:             "  La pression est de %s\n\n",
+			CurrentWeather:       "\nLe temps actuel est %s à %s pour %s\n",
+			Temperature:          "La température est de %s\n\n",
+			TemperatureFeelsLike: "La température est de %s, mais ressentie comme %s\n\n",
+			AlertCreated:         "Créé : ",
+			AlertExpires:         "Expire : ",
+			DailyHigh:            "Le maximum est de %s, ressenti comme %s vers %s,\n",
+			DailyLow:             "et le minimum est de %s, ressenti comme %s vers %s\n\n",
+			Directions:           []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSO", "SO", "OSO", "O", "ONO", "NO", "NNO"},
+			LongDate:             "2 January à 15:04 MST",
+			DailyDate:            "2 January (Monday)",
+			Hour:                 "15:04 MST",
+		},
+		"ru": {
+			HumidityIck:          "  Фу! Влажность составляет %s\n",
+			Humidity:             "  Влажность составляет %s\n",
+			PrecipIntensity:      "  Интенсивность осадков (%s) составляет %s\n",
+			PrecipProbability:    "  Вероятность осадков составляет %s\n",
+			NearestStorm:         "  Ближайшая гроза на расстоянии %s\n",
+			WindSpeed:            "  Скорость ветра составляет %s\n",
+			CloudCoverage:        "  Облачность составляет %s\n",
+			Visibility:           "  Видимость составляет %s\n",
+			Pressure:             "  Давление составляет %s\n\n",
+			CurrentWeather:       "\nСейчас %s в %s, по состоянию на %s\n",
+			Temperature:          "Температура %s\n\n",
+			TemperatureFeelsLike: "Температура %s, но ощущается как %s\n\n",
+			AlertCreated:         "Создано: ",
+			AlertExpires:         "Истекает: ",
+			DailyHigh:            "Максимум температуры %s, ощущается как %s около %s,\n",
+			DailyLow:             "а минимум %s, ощущается как %s около %s\n\n",
+			Directions:           []string{"С", "ССВ", "СВ", "ВСВ", "В", "ВЮВ", "ЮВ", "ЮЮВ", "Ю", "ЮЮЗ", "ЮЗ", "ЗЮЗ", "З", "ЗСЗ", "СЗ", "ССЗ"},
+			LongDate:             "2 January, 15:04 MST",
+			DailyDate:            "2 January (Monday)",
+			Hour:                 "15:04 MST",
+		},
+	}
+)
+
+// RegisterLang registers (or overrides) the Catalog used for the given
+// BCP-47 language tag, e.g. RegisterLang("es", esCatalog).
+func RegisterLang(tag string, cat Catalog) {
+	langsMu.Lock()
+	defer langsMu.Unlock()
+	langs[tag] = cat
+}
+
+// catalogFor returns the Catalog for tag, falling back to English for an
+// unregistered or empty tag.
+func catalogFor(tag string) Catalog {
+	langsMu.RLock()
+	defer langsMu.RUnlock()
+
+	if cat, ok := langs[tag]; ok {
+		return cat
+	}
+	return langs["en"]
+}
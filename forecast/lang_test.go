@@ -0,0 +1,36 @@
+package forecast
+
+import "testing"
+
+// TestDirectionsTables guards against the kind of copy/paste compass-table
+// bug that shipped in the fi and ru catalogs: every registered language must
+// have exactly 16 distinct, non-empty direction abbreviations.
+func TestDirectionsTables(t *testing.T) {
+	for _, tag := range []string{"en", "de", "fi", "fr", "ru"} {
+		t.Run(tag, func(t *testing.T) {
+			dirs := catalogFor(tag).Directions
+			if len(dirs) != 16 {
+				t.Fatalf("catalogFor(%q).Directions has %d entries, want 16", tag, len(dirs))
+			}
+
+			seen := make(map[string]int, 16)
+			for i, d := range dirs {
+				if d == "" {
+					t.Errorf("catalogFor(%q).Directions[%d] is empty", tag, i)
+				}
+				seen[d]++
+			}
+			for d, count := range seen {
+				if count > 1 {
+					t.Errorf("catalogFor(%q).Directions contains %q %d times, want it unique", tag, d, count)
+				}
+			}
+		})
+	}
+}
+
+func TestCatalogForFallsBackToEnglish(t *testing.T) {
+	if got := catalogFor("xx"); got.Hour != catalogFor("en").Hour {
+		t.Errorf("catalogFor(%q) did not fall back to the English catalog", "xx")
+	}
+}
@@ -0,0 +1,185 @@
+package forecast
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const openMeteoBaseURL = "https://api.open-meteo.com/v1/forecast"
+
+// OpenMeteo is a Provider backed by the free, keyless Open-Meteo API. Unlike
+// Dark Sky it requires no API key and has no rate limit for personal use.
+type OpenMeteo struct{}
+
+// openMeteoResponse is the subset of the Open-Meteo /v1/forecast response
+// this package consumes. timeformat=unixtime is requested so every Time
+// field lines up with this package's epoch-seconds convention.
+type openMeteoResponse struct {
+	Current struct {
+		Time                int64   `json:"time"`
+		Temperature2m       float64 `json:"temperature_2m"`
+		ApparentTemperature float64 `json:"apparent_temperature"`
+		WeatherCode         int     `json:"weather_code"`
+	} `json:"current"`
+	Hourly struct {
+		Time                     []int64   `json:"time"`
+		Temperature2m            []float64 `json:"temperature_2m"`
+		PrecipitationProbability []float64 `json:"precipitation_probability"`
+	} `json:"hourly"`
+	Daily struct {
+		Time             []int64   `json:"time"`
+		Sunrise          []int64   `json:"sunrise"`
+		Sunset           []int64   `json:"sunset"`
+		Temperature2mMax []float64 `json:"temperature_2m_max"`
+		Temperature2mMin []float64 `json:"temperature_2m_min"`
+	} `json:"daily"`
+}
+
+// openMeteoUnits maps this package's units key to Open-Meteo's
+// temperature_unit, wind_speed_unit, and precipitation_unit query params.
+func openMeteoUnits(units string) (temperature, wind, precipitation string) {
+	switch units {
+	case "us":
+		return "fahrenheit", "mph", "inch"
+	case "ca":
+		return "celsius", "kmh", "mm"
+	case "uk", "uk2":
+		return "celsius", "mph", "mm"
+	default: // "si"
+		return "celsius", "ms", "mm"
+	}
+}
+
+// openMeteoSummary maps Open-Meteo's integer WMO weather_code to a short
+// human-readable summary, following the same bucketing as openMeteoIcon.
+func openMeteoSummary(code int) string {
+	switch {
+	case code == 0:
+		return "Clear"
+	case code >= 1 && code <= 3:
+		return "Partly cloudy"
+	case code == 45 || code == 48:
+		return "Fog"
+	case code >= 51 && code <= 67:
+		return "Rain"
+	case code >= 71 && code <= 77:
+		return "Snow"
+	case code >= 80 && code <= 82:
+		return "Rain"
+	case code >= 95 && code <= 99:
+		return "Thunderstorm"
+	default:
+		return "Cloudy"
+	}
+}
+
+// openMeteoIcon maps Open-Meteo's integer WMO weather_code to this package's
+// icon names, resolving day/night via the given sunrise/sunset window.
+func openMeteoIcon(code int, t, sunrise, sunset int64) string {
+	// An all-zero window means daylightWindow couldn't find a matching daily
+	// entry (e.g. the Daily fetch came back empty or partial); default to
+	// day rather than silently reporting night for every reading.
+	day := (sunrise == 0 && sunset == 0) || (t >= sunrise && t < sunset)
+
+	switch {
+	case code == 0:
+		if day {
+			return "clearday"
+		}
+		return "clearnight"
+	case code >= 1 && code <= 3:
+		if day {
+			return "partlycloudyday"
+		}
+		return "partlycloudynight"
+	case code == 45 || code == 48:
+		return "fog"
+	case code >= 51 && code <= 67:
+		return "rain"
+	case code >= 71 && code <= 77:
+		return "snow"
+	case code >= 80 && code <= 82:
+		return "rain"
+	case code >= 95 && code <= 99:
+		return "thunderstorm"
+	default:
+		return "cloudy"
+	}
+}
+
+// Fetch retrieves the forecast from Open-Meteo for the given coordinates.
+func (o OpenMeteo) Fetch(lat, lon float64, units string) (Forecast, error) {
+	var f Forecast
+
+	temperatureUnit, windUnit, precipitationUnit := openMeteoUnits(units)
+
+	url := fmt.Sprintf(
+		"%s?latitude=%f&longitude=%f&current=temperature_2m,apparent_temperature,weather_code"+
+			"&hourly=temperature_2m,precipitation_probability&daily=sunrise,sunset,temperature_2m_max,temperature_2m_min"+
+			"&temperature_unit=%s&wind_speed_unit=%s&precipitation_unit=%s&timeformat=unixtime",
+		openMeteoBaseURL, lat, lon, temperatureUnit, windUnit, precipitationUnit,
+	)
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return f, fmt.Errorf("getting open-meteo forecast: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return f, fmt.Errorf("open-meteo: unexpected status code %d", resp.StatusCode)
+	}
+
+	var r openMeteoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return f, fmt.Errorf("decoding open-meteo forecast: %v", err)
+	}
+
+	f.Flags.Units = units
+
+	for i, dayTime := range r.Daily.Time {
+		daily := Weather{
+			Time: dayTime,
+		}
+		if i < len(r.Daily.Sunrise) {
+			daily.SunriseTime = r.Daily.Sunrise[i]
+		}
+		if i < len(r.Daily.Sunset) {
+			daily.SunsetTime = r.Daily.Sunset[i]
+		}
+		if i < len(r.Daily.Temperature2mMax) {
+			daily.TemperatureMax = r.Daily.Temperature2mMax[i]
+		}
+		if i < len(r.Daily.Temperature2mMin) {
+			daily.TemperatureMin = r.Daily.Temperature2mMin[i]
+		}
+		f.Daily.Data = append(f.Daily.Data, daily)
+	}
+
+	// Open-Meteo's weather_code doesn't distinguish day from night, so pick
+	// the icon using the daylight window of the day the reading falls in.
+	sunrise, sunset := daylightWindow(f.Daily, r.Current.Time)
+	f.Currently = Weather{
+		Time:                r.Current.Time,
+		Summary:             openMeteoSummary(r.Current.WeatherCode),
+		Temperature:         r.Current.Temperature2m,
+		ApparentTemperature: r.Current.ApparentTemperature,
+		Icon:                openMeteoIcon(r.Current.WeatherCode, r.Current.Time, sunrise, sunset),
+	}
+
+	for i, hourTime := range r.Hourly.Time {
+		hourly := Weather{
+			Time: hourTime,
+		}
+		if i < len(r.Hourly.Temperature2m) {
+			hourly.Temperature = r.Hourly.Temperature2m[i]
+		}
+		if i < len(r.Hourly.PrecipitationProbability) {
+			hourly.PrecipProbability = r.Hourly.PrecipitationProbability[i] / 100
+		}
+		f.Hourly.Data = append(f.Hourly.Data, hourly)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,67 @@
+package forecast
+
+import "testing"
+
+func TestOpenMeteoIcon(t *testing.T) {
+	const (
+		sunrise = int64(1000)
+		sunset  = int64(2000)
+		noon    = int64(1500)
+		night   = int64(2500)
+	)
+
+	tests := []struct {
+		name string
+		code int
+		t    int64
+		want string
+	}{
+		{"clear day", 0, noon, "clearday"},
+		{"clear night", 0, night, "clearnight"},
+		{"partly cloudy day", 2, noon, "partlycloudyday"},
+		{"partly cloudy night", 3, night, "partlycloudynight"},
+		{"fog", 45, noon, "fog"},
+		{"fog alt code", 48, night, "fog"},
+		{"drizzle/rain", 55, noon, "rain"},
+		{"rain showers", 80, noon, "rain"},
+		{"snow", 73, noon, "snow"},
+		{"thunderstorm", 95, night, "thunderstorm"},
+		{"unknown code", 9, noon, "cloudy"},
+		{"unknown sunrise/sunset defaults to day", 0, 0, "clearday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sr, ss := sunrise, sunset
+			if tt.t == 0 {
+				sr, ss = 0, 0
+			}
+			if got := openMeteoIcon(tt.code, tt.t, sr, ss); got != tt.want {
+				t.Errorf("openMeteoIcon(%d, %d, %d, %d) = %q, want %q", tt.code, tt.t, sr, ss, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenMeteoSummary(t *testing.T) {
+	tests := []struct {
+		code int
+		want string
+	}{
+		{0, "Clear"},
+		{2, "Partly cloudy"},
+		{45, "Fog"},
+		{48, "Fog"},
+		{61, "Rain"},
+		{80, "Rain"},
+		{73, "Snow"},
+		{96, "Thunderstorm"},
+		{9, "Cloudy"},
+	}
+
+	for _, tt := range tests {
+		if got := openMeteoSummary(tt.code); got != tt.want {
+			t.Errorf("openMeteoSummary(%d) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package forecast
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mitchellh/colorstring"
+)
+
+// OutputFormat selects how the Print* functions render forecast data.
+type OutputFormat int
+
+const (
+	// Pretty renders colorized, human-readable prose. This is the default.
+	Pretty OutputFormat = iota
+	// JSON renders a stable, machine-readable JSON document with raw
+	// numeric values and no prose, for scripts and status bars.
+	JSON
+	// Plain renders the same prose as Pretty, but with every colorstring
+	// call bypassed, so the output is safe to pipe into grep/awk.
+	Plain
+)
+
+// PrintOptions controls how PrintCurrent, PrintDaily, and PrintHourly
+// render their output.
+type PrintOptions struct {
+	Format OutputFormat
+	// Lang is a BCP-47 language tag (e.g. "en", "de", "fi") selecting which
+	// registered Catalog to render prose from. Empty falls back to "en".
+	Lang string
+}
+
+// plainColorize strips colorstring's [color]text markup instead of
+// rendering it, so Plain mode output is safe to pipe into grep/awk.
+var plainColorize = colorstring.Colorize{Colors: colorstring.DefaultColors, Disable: true}
+
+// colorize applies colorstring's [color]text markup in Pretty mode, and
+// strips it to plain text in Plain mode.
+func colorize(format OutputFormat, s string) string {
+	if format == Plain {
+		return plainColorize.Color(s)
+	}
+	return colorstring.Color(s)
+}
+
+// jsonLocation is the JSON representation of a geocode.Geocode.
+type jsonLocation struct {
+	City   string `json:"city"`
+	Region string `json:"region"`
+}
+
+// jsonAlert is the JSON representation of a forecast Alert.
+type jsonAlert struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Created     int64  `json:"created"`
+	Expires     int64  `json:"expires"`
+}
+
+// jsonWeather is the JSON representation of a single Weather reading,
+// whether current, daily, or hourly.
+type jsonWeather struct {
+	Time                   int64   `json:"time"`
+	Summary                string  `json:"summary,omitempty"`
+	Temperature            float64 `json:"temperature"`
+	ApparentTemperature    float64 `json:"apparent_temperature"`
+	TemperatureMax         float64 `json:"temperature_max"`
+	TemperatureMin         float64 `json:"temperature_min"`
+	ApparentTemperatureMax float64 `json:"apparent_temperature_max"`
+	ApparentTemperatureMin float64 `json:"apparent_temperature_min"`
+	Humidity               float64 `json:"humidity"`
+	PrecipIntensity        float64 `json:"precip_intensity"`
+	PrecipProbability      float64 `json:"precip_probability"`
+	PrecipType             string  `json:"precip_type,omitempty"`
+	WindSpeed              float64 `json:"wind_speed"`
+	WindBearing            float64 `json:"wind_bearing"`
+	CloudCover             float64 `json:"cloud_cover"`
+	Visibility             float64 `json:"visibility"`
+	Pressure               float64 `json:"pressure"`
+}
+
+func newJSONWeather(w Weather) jsonWeather {
+	return jsonWeather{
+		Time:                   w.Time,
+		Summary:                w.Summary,
+		Temperature:            w.Temperature,
+		ApparentTemperature:    w.ApparentTemperature,
+		TemperatureMax:         w.TemperatureMax,
+		TemperatureMin:         w.TemperatureMin,
+		ApparentTemperatureMax: w.ApparentTemperatureMax,
+		ApparentTemperatureMin: w.ApparentTemperatureMin,
+		Humidity:               w.Humidity,
+		PrecipIntensity:        w.PrecipIntensity,
+		PrecipProbability:      w.PrecipProbability,
+		PrecipType:             w.PrecipType,
+		WindSpeed:              w.WindSpeed,
+		WindBearing:            w.WindBearing,
+		CloudCover:             w.CloudCover,
+		Visibility:             w.Visibility,
+		Pressure:               w.Pressure,
+	}
+}
+
+// jsonCurrent is the JSON schema emitted by PrintCurrent in JSON mode.
+type jsonCurrent struct {
+	Location jsonLocation `json:"location"`
+	Units    UnitMeasures `json:"units"`
+	Current  jsonWeather  `json:"current"`
+	Alerts   []jsonAlert  `json:"alerts,omitempty"`
+}
+
+// jsonDaily is the JSON schema emitted by PrintDaily in JSON mode.
+type jsonDaily struct {
+	Units UnitMeasures  `json:"units"`
+	Days  []jsonWeather `json:"days"`
+}
+
+// jsonHourly is the JSON schema emitted by PrintHourly in JSON mode.
+type jsonHourly struct {
+	Units UnitMeasures  `json:"units"`
+	Hours []jsonWeather `json:"hours"`
+}
+
+func printJSON(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshaling json output: %v", err)
+	}
+	fmt.Println(string(b))
+	return nil
+}
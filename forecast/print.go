@@ -7,8 +7,6 @@ import (
 	"time"
 
 	"github.com/jessfraz/weather/geocode"
-	"github.com/jessfraz/weather/icons"
-	"github.com/mitchellh/colorstring"
 )
 
 // UnitMeasures are the location specific terms for weather data.
@@ -17,8 +15,6 @@ type UnitMeasures struct {
 	Speed         string
 	Length        string
 	Precipitation string
-	LongDate      string
-	Hour          string
 }
 
 var (
@@ -29,24 +25,18 @@ var (
 			Speed:         "mph",
 			Length:        "miles",
 			Precipitation: "in/hr",
-			LongDate:      "January 2 at 3:04pm MST",
-			Hour:          "3:04pm MST",
 		},
 		"si": {
 			Degrees:       "°C",
 			Speed:         "m/s",
 			Length:        "kilometers",
 			Precipitation: "mm/h",
-			LongDate:      "2006-01-02 15:04:05 EET",
-			Hour:          "15:04 EET",
 		},
 		"ca": {
 			Degrees:       "°C",
 			Speed:         "km/h",
 			Length:        "kilometers",
 			Precipitation: "mm/h",
-			LongDate:      "January 2 at 3:04pm MST",
-			Hour:          "3:04pm MST",
 		},
 		// deprecated, use "uk2" in stead
 		"uk": {
@@ -54,153 +44,127 @@ var (
 			Speed:         "mph",
 			Length:        "kilometers",
 			Precipitation: "mm/h",
-			LongDate:      "January 2 at 15:04 MST",
-			Hour:          "15:04 MST",
 		},
 		"uk2": {
 			Degrees:       "°C",
 			Speed:         "mph",
 			Length:        "miles",
 			Precipitation: "mm/h",
-			LongDate:      "January 2 at 15:04 MST",
-			Hour:          "15:04 MST",
 		},
 	}
-	// Directions contain all the combinations of N,S,E,W
-	Directions = []string{
-		"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
-	}
+	// hourlyBlocks are the Unicode block elements used to render PrintHourly's
+	// bar chart, from lowest to tallest.
+	hourlyBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
 )
 
-func epochFormat(seconds int64, unitsFormat UnitMeasures) string {
+// hourlyPrecipThreshold is the precipitation probability (0..1) above which
+// PrintHourly marks an hour as rainy.
+const hourlyPrecipThreshold = 0.3
+
+func epochFormat(seconds int64, cat Catalog) string {
 	epochTime := time.Unix(0, seconds*int64(time.Second))
-	return epochTime.Format(unitsFormat.LongDate)
+	return epochTime.Format(cat.LongDate)
 }
 
-func epochFormatDate(seconds int64) string {
+func epochFormatDate(seconds int64, cat Catalog) string {
 	epochTime := time.Unix(0, seconds*int64(time.Second))
-	return epochTime.Format("January 2 (Monday)")
+	return epochTime.Format(cat.DailyDate)
 }
 
-func epochFormatTime(seconds int64, unitsFormat UnitMeasures) string {
+func epochFormatTime(seconds int64, cat Catalog) string {
 	epochTime := time.Unix(0, seconds*int64(time.Second))
-	return epochTime.Format(unitsFormat.Hour)
+	return epochTime.Format(cat.Hour)
 }
 
-func getIcon(iconStr string) (icon string, err error) {
-	color := "blue"
-	// steralize the icon string name
-	iconStr = strings.Replace(strings.Replace(iconStr, "-", "", -1), "_", "", -1)
-
-	switch iconStr {
-	case "clear":
-		icon = icons.Clear
-	case "clearday":
-		color = "yellow"
-		icon = icons.Clearday
-	case "clearnight":
-		color = "light_yellow"
-		icon = icons.Clearnight
-	case "clouds":
-		icon = icons.Clouds
-	case "cloudy":
-		icon = icons.Cloudy
-	case "cloudsnight":
-		color = "light_yellow"
-		icon = icons.Cloudsnight
-	case "fog":
-		icon = icons.Fog
-	case "haze":
-		icon = icons.Haze
-	case "hazenight":
-		color = "light_yellow"
-		icon = icons.Hazenight
-	case "partlycloudyday":
-		color = "yellow"
-		icon = icons.Partlycloudyday
-	case "partlycloudynight":
-		color = "light_yellow"
-		icon = icons.Partlycloudynight
-	case "rain":
-		icon = icons.Rain
-	case "sleet":
-		icon = icons.Sleet
-	case "snow":
-		color = "white"
-		icon = icons.Snow
-	case "thunderstorm":
-		color = "black"
-		icon = icons.Thunderstorm
-	case "tornado":
-		color = "black"
-		icon = icons.Tornado
-	case "wind":
-		color = "black"
-		icon = icons.Wind
+func getIcon(iconStr string, t, sunrise, sunset int64, format OutputFormat) (string, error) {
+	markup, err := ANSIIconRenderer{}.RenderANSI(resolveIcon(iconStr, t, sunrise, sunset))
+	if err != nil {
+		return "", err
 	}
 
-	return colorstring.Color("[" + color + "]" + icon), nil
+	return colorize(format, markup), nil
 }
 
-func getBearingDetails(degrees float64) string {
+func getBearingDetails(degrees float64, cat Catalog) string {
 	index := int(math.Mod((degrees+11.25)/22.5, 16))
-	return Directions[index]
+	return cat.Directions[index]
 }
 
-func printCommon(weather Weather, unitsFormat UnitMeasures) error {
+func printCommon(weather Weather, unitsFormat UnitMeasures, format OutputFormat, cat Catalog) error {
 	if weather.Humidity > 0 {
-		humidity := colorstring.Color(fmt.Sprintf("[white]%v%s", weather.Humidity*100, "%"))
+		humidity := colorize(format, fmt.Sprintf("[white]%v%s", weather.Humidity*100, "%"))
 		if weather.Humidity > 0.20 {
-			fmt.Printf("  Ick! The humidity is %s\n", humidity)
+			fmt.Printf(cat.HumidityIck, humidity)
 		} else {
-			fmt.Printf("  The humidity is %s\n", humidity)
+			fmt.Printf(cat.Humidity, humidity)
 		}
 	}
 
 	if weather.PrecipIntensity > 0 {
-		precInt := colorstring.Color(fmt.Sprintf("[white]%v %s", weather.PrecipIntensity, unitsFormat.Precipitation))
-		fmt.Printf("  The precipitation intensity of %s is %s\n", colorstring.Color("[white]"+weather.PrecipType), precInt)
+		precInt := colorize(format, fmt.Sprintf("[white]%v %s", weather.PrecipIntensity, unitsFormat.Precipitation))
+		fmt.Printf(cat.PrecipIntensity, colorize(format, "[white]"+weather.PrecipType), precInt)
 	}
 
 	if weather.PrecipProbability > 0 {
-		prec := colorstring.Color(fmt.Sprintf("[white]%v%s", weather.PrecipProbability*100, "%"))
-		fmt.Printf("  The precipitation probability is %s\n", prec)
+		prec := colorize(format, fmt.Sprintf("[white]%v%s", weather.PrecipProbability*100, "%"))
+		fmt.Printf(cat.PrecipProbability, prec)
 	}
 
 	if weather.NearestStormDistance > 0 {
-		dist := colorstring.Color(fmt.Sprintf("[white]%v %s %v", weather.NearestStormDistance, unitsFormat.Length, getBearingDetails(weather.NearestStormBearing)))
-		fmt.Printf("  The nearest storm is %s away\n", dist)
+		dist := colorize(format, fmt.Sprintf("[white]%v %s %v", weather.NearestStormDistance, unitsFormat.Length, getBearingDetails(weather.NearestStormBearing, cat)))
+		fmt.Printf(cat.NearestStorm, dist)
 	}
 
 	if weather.WindSpeed > 0 {
-		wind := colorstring.Color(fmt.Sprintf("[white]%v %s %v", weather.WindSpeed, unitsFormat.Speed, getBearingDetails(weather.WindBearing)))
-		fmt.Printf("  The wind speed is %s\n", wind)
+		wind := colorize(format, fmt.Sprintf("[white]%v %s %v", weather.WindSpeed, unitsFormat.Speed, getBearingDetails(weather.WindBearing, cat)))
+		fmt.Printf(cat.WindSpeed, wind)
 	}
 
 	if weather.CloudCover > 0 {
-		cloudCover := colorstring.Color(fmt.Sprintf("[white]%v%s", weather.CloudCover*100, "%"))
-		fmt.Printf("  The cloud coverage is %s\n", cloudCover)
+		cloudCover := colorize(format, fmt.Sprintf("[white]%v%s", weather.CloudCover*100, "%"))
+		fmt.Printf(cat.CloudCoverage, cloudCover)
 	}
 
 	if weather.Visibility < 10 {
-		visibility := colorstring.Color(fmt.Sprintf("[white]%v %s", weather.Visibility, unitsFormat.Length))
-		fmt.Printf("  The visibility is %s\n", visibility)
+		visibility := colorize(format, fmt.Sprintf("[white]%v %s", weather.Visibility, unitsFormat.Length))
+		fmt.Printf(cat.Visibility, visibility)
 	}
 
 	if weather.Pressure > 0 {
-		pressure := colorstring.Color(fmt.Sprintf("[white]%v %s", weather.Pressure, "mbar"))
-		fmt.Printf("  The pressure is %s\n\n", pressure)
+		pressure := colorize(format, fmt.Sprintf("[white]%v %s", weather.Pressure, "mbar"))
+		fmt.Printf(cat.Pressure, pressure)
 	}
 
 	return nil
 }
 
 // PrintCurrent pretty prints the current forecast data.
-func PrintCurrent(forecast Forecast, geolocation geocode.Geocode, ignoreAlerts bool, hideIcon bool) error {
+func PrintCurrent(forecast Forecast, geolocation geocode.Geocode, ignoreAlerts bool, hideIcon bool, opts PrintOptions) error {
 	unitsFormat := UnitFormats[forecast.Flags.Units]
+	cat := catalogFor(opts.Lang)
+
+	if opts.Format == JSON {
+		out := jsonCurrent{
+			Location: jsonLocation{City: geolocation.City, Region: geolocation.Region},
+			Units:    unitsFormat,
+			Current:  newJSONWeather(forecast.Currently),
+		}
+		if !ignoreAlerts {
+			for _, alert := range forecast.Alerts {
+				out.Alerts = append(out.Alerts, jsonAlert{
+					Title:       alert.Title,
+					Description: alert.Description,
+					Created:     alert.Time,
+					Expires:     alert.Expires,
+				})
+			}
+		}
+		return printJSON(out)
+	}
 
 	if !hideIcon {
-		icon, err := getIcon(forecast.Currently.Icon)
+		sunrise, sunset := daylightWindow(forecast.Daily, forecast.Currently.Time)
+		icon, err := getIcon(forecast.Currently.Icon, forecast.Currently.Time, sunrise, sunset, opts.Format)
 		if err != nil {
 			return err
 		}
@@ -208,36 +172,48 @@ func PrintCurrent(forecast Forecast, geolocation geocode.Geocode, ignoreAlerts b
 		fmt.Println(icon)
 	}
 
-	location := colorstring.Color(fmt.Sprintf("[green]%s in %s", geolocation.City, geolocation.Region))
-	fmt.Printf("\nCurrent weather is %s in %s for %s\n", colorstring.Color("[cyan]"+forecast.Currently.Summary), location, colorstring.Color("[cyan]"+epochFormat(forecast.Currently.Time, unitsFormat)))
+	location := colorize(opts.Format, fmt.Sprintf("[green]%s in %s", geolocation.City, geolocation.Region))
+	fmt.Printf(cat.CurrentWeather, colorize(opts.Format, "[cyan]"+forecast.Currently.Summary), location, colorize(opts.Format, "[cyan]"+epochFormat(forecast.Currently.Time, cat)))
 
-	temp := colorstring.Color(fmt.Sprintf("[magenta]%v%s", forecast.Currently.Temperature, unitsFormat.Degrees))
-	feelslike := colorstring.Color(fmt.Sprintf("[magenta]%v%s", forecast.Currently.ApparentTemperature, unitsFormat.Degrees))
+	temp := colorize(opts.Format, fmt.Sprintf("[magenta]%v%s", forecast.Currently.Temperature, unitsFormat.Degrees))
+	feelslike := colorize(opts.Format, fmt.Sprintf("[magenta]%v%s", forecast.Currently.ApparentTemperature, unitsFormat.Degrees))
 	if temp == feelslike {
-		fmt.Printf("The temperature is %s\n\n", temp)
+		fmt.Printf(cat.Temperature, temp)
 	} else {
-		fmt.Printf("The temperature is %s, but it feels like %s\n\n", temp, feelslike)
+		fmt.Printf(cat.TemperatureFeelsLike, temp, feelslike)
 	}
 
 	if !ignoreAlerts {
 		for _, alert := range forecast.Alerts {
 			if alert.Title != "" {
-				fmt.Println(colorstring.Color("[red]" + alert.Title))
+				fmt.Println(colorize(opts.Format, "[red]"+alert.Title))
 			}
 			if alert.Description != "" {
-				fmt.Print(colorstring.Color("[red]" + alert.Description))
+				fmt.Print(colorize(opts.Format, "[red]"+alert.Description))
 			}
-			fmt.Println("\t\t\t" + colorstring.Color("[red]Created: "+epochFormat(alert.Time, unitsFormat)))
-			fmt.Println("\t\t\t" + colorstring.Color("[red]Expires: "+epochFormat(alert.Expires, unitsFormat)) + "\n")
+			fmt.Println("\t\t\t" + colorize(opts.Format, "[red]"+cat.AlertCreated+epochFormat(alert.Time, cat)))
+			fmt.Println("\t\t\t" + colorize(opts.Format, "[red]"+cat.AlertExpires+epochFormat(alert.Expires, cat)) + "\n")
 		}
 	}
 
-	return printCommon(forecast.Currently, unitsFormat)
+	return printCommon(forecast.Currently, unitsFormat, opts.Format, cat)
 }
 
 // PrintDaily pretty prints the daily forecast data.
-func PrintDaily(forecast Forecast, days int) error {
+func PrintDaily(forecast Forecast, days int, opts PrintOptions) error {
 	unitsFormat := UnitFormats[forecast.Flags.Units]
+	cat := catalogFor(opts.Lang)
+
+	if opts.Format == JSON {
+		out := jsonDaily{Units: unitsFormat}
+		for index, daily := range forecast.Daily.Data[1:] {
+			if index == days {
+				break
+			}
+			out.Days = append(out.Days, newJSONWeather(daily))
+		}
+		return printJSON(out)
+	}
 
 	// Ignore the current day as it's printed before
 	for index, daily := range forecast.Daily.Data[1:] {
@@ -246,17 +222,92 @@ func PrintDaily(forecast Forecast, days int) error {
 			break
 		}
 
-		fmt.Println(colorstring.Color("[magenta]" + epochFormatDate(daily.Time)))
+		fmt.Println(colorize(opts.Format, "[magenta]"+epochFormatDate(daily.Time, cat)))
+
+		tempMax := colorize(opts.Format, fmt.Sprintf("[blue]%v%s", daily.TemperatureMax, unitsFormat.Degrees))
+		tempMin := colorize(opts.Format, fmt.Sprintf("[blue]%v%s", daily.TemperatureMin, unitsFormat.Degrees))
+		feelsLikeMax := colorize(opts.Format, fmt.Sprintf("[cyan]%v%s", daily.ApparentTemperatureMax, unitsFormat.Degrees))
+		feelsLikeMin := colorize(opts.Format, fmt.Sprintf("[cyan]%v%s", daily.ApparentTemperatureMin, unitsFormat.Degrees))
+		fmt.Printf(cat.DailyHigh, tempMax, feelsLikeMax, epochFormatTime(daily.TemperatureMaxTime, cat))
+		fmt.Printf(cat.DailyLow, tempMin, feelsLikeMin, epochFormatTime(daily.TemperatureMinTime, cat))
+
+		printCommon(daily, unitsFormat, opts.Format, cat)
+	}
+
+	return nil
+}
+
+// PrintHourly pretty prints the next `hours` hours of forecast data as a
+// compact column chart: a row of scaled temperature bars, a row marking
+// hours with a meaningful chance of precipitation, and an hour-of-day label
+// row underneath.
+func PrintHourly(forecast Forecast, hours int, opts PrintOptions) error {
+	unitsFormat := UnitFormats[forecast.Flags.Units]
+	cat := catalogFor(opts.Lang)
+
+	data := forecast.Hourly.Data
+	if hours >= 0 && hours < len(data) {
+		data = data[:hours]
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	if opts.Format == JSON {
+		out := jsonHourly{Units: unitsFormat}
+		for _, hour := range data {
+			out.Hours = append(out.Hours, newJSONWeather(hour))
+		}
+		return printJSON(out)
+	}
+
+	min, max := data[0].Temperature, data[0].Temperature
+	for _, hour := range data {
+		if hour.Temperature < min {
+			min = hour.Temperature
+		}
+		if hour.Temperature > max {
+			max = hour.Temperature
+		}
+	}
 
-		tempMax := colorstring.Color(fmt.Sprintf("[blue]%v%s", daily.TemperatureMax, unitsFormat.Degrees))
-		tempMin := colorstring.Color(fmt.Sprintf("[blue]%v%s", daily.TemperatureMin, unitsFormat.Degrees))
-		feelsLikeMax := colorstring.Color(fmt.Sprintf("[cyan]%v%s", daily.ApparentTemperatureMax, unitsFormat.Degrees))
-		feelsLikeMin := colorstring.Color(fmt.Sprintf("[cyan]%v%s", daily.ApparentTemperatureMin, unitsFormat.Degrees))
-		fmt.Printf("The temperature high is %s, feels like %s around %s,\n", tempMax, feelsLikeMax, epochFormatTime(daily.TemperatureMaxTime, unitsFormat))
-		fmt.Printf("and low is %s, feels like %s around %s\n\n", tempMin, feelsLikeMin, epochFormatTime(daily.TemperatureMinTime, unitsFormat))
+	var bars, precip, labels []string
+	for _, hour := range data {
+		row := 0
+		if max > min {
+			row = int(math.Round(((hour.Temperature - min) / (max - min)) * float64(len(hourlyBlocks)-1)))
+		}
+		if row < 0 {
+			row = 0
+		} else if row > len(hourlyBlocks)-1 {
+			row = len(hourlyBlocks) - 1
+		}
+
+		label := epochFormatTime(hour.Time, cat)
+		width := len(label)
 
-		printCommon(daily, unitsFormat)
+		bars = append(bars, fmt.Sprintf("%*s", width, string(hourlyBlocks[row])))
+
+		marker := " "
+		if hour.PrecipProbability > hourlyPrecipThreshold {
+			marker = "▓"
+		}
+		padded := fmt.Sprintf("%*s", width, marker)
+		if marker != " " {
+			padded = colorize(opts.Format, "[blue]"+padded)
+		}
+		precip = append(precip, padded)
+
+		labels = append(labels, label)
 	}
 
+	fmt.Println(strings.Join(bars, " "))
+	fmt.Println(strings.Join(precip, " "))
+	fmt.Println(strings.Join(labels, " "))
+
+	low := colorize(opts.Format, fmt.Sprintf("[blue]%v%s", min, unitsFormat.Degrees))
+	high := colorize(opts.Format, fmt.Sprintf("[blue]%v%s", max, unitsFormat.Degrees))
+	fmt.Printf("low %s, high %s\n", low, high)
+
 	return nil
 }
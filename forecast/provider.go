@@ -0,0 +1,12 @@
+package forecast
+
+// Provider fetches a Forecast for a given location from a specific weather
+// backend. Implementations are responsible for normalizing their upstream
+// response into this package's Forecast/Weather shape, including unit
+// handling and icon selection, so that callers (and PrintCurrent/PrintDaily)
+// never need to know which backend produced the data.
+type Provider interface {
+	// Fetch retrieves the forecast for the given coordinates. units is one
+	// of the UnitFormats keys ("us", "si", "ca", "uk", "uk2").
+	Fetch(lat, lon float64, units string) (Forecast, error)
+}